@@ -0,0 +1,31 @@
+package api
+
+import "context"
+
+// Account is a linked financial account.
+type Account struct {
+	ID      string  `json:"id" yaml:"id"`
+	Name    string  `json:"name" yaml:"name"`
+	Type    string  `json:"type" yaml:"type"`
+	Balance float64 `json:"balance" yaml:"balance"`
+}
+
+const listAccountsQuery = `query ListAccounts {
+	accounts {
+		id
+		name
+		type
+		balance
+	}
+}`
+
+// ListAccounts fetches every account linked to the authenticated user.
+func (c *Client) ListAccounts(ctx context.Context) ([]Account, error) {
+	var out struct {
+		Accounts []Account `json:"accounts"`
+	}
+	if err := c.do(ctx, listAccountsQuery, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Accounts, nil
+}
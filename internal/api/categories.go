@@ -0,0 +1,31 @@
+package api
+
+import "context"
+
+// Category is a budgeting category transactions can be grouped under.
+type Category struct {
+	ID     string `json:"id" yaml:"id"`
+	Name   string `json:"name" yaml:"name"`
+	Group  string `json:"group" yaml:"group"`
+	Hidden bool   `json:"hidden" yaml:"hidden"`
+}
+
+const listCategoriesQuery = `query ListCategories {
+	categories {
+		id
+		name
+		group
+		hidden
+	}
+}`
+
+// ListCategories fetches every category defined for the authenticated user.
+func (c *Client) ListCategories(ctx context.Context) ([]Category, error) {
+	var out struct {
+		Categories []Category `json:"categories"`
+	}
+	if err := c.do(ctx, listCategoriesQuery, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Categories, nil
+}
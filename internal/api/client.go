@@ -0,0 +1,144 @@
+// Package api is a thin, typed client for Copilot Money's GraphQL endpoint.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const defaultEndpoint = "https://api.copilot.money/graphql"
+
+// Client issues authenticated GraphQL requests against Copilot's API.
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+	maxRetries int
+}
+
+// Option configures a Client returned by NewClient.
+type Option func(*Client)
+
+// WithEndpoint overrides the default GraphQL endpoint, mainly for tests.
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) { c.endpoint = endpoint }
+}
+
+// WithHTTPClient overrides the http.Client used to issue requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithMaxRetries overrides how many times a failed request is retried.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) { c.maxRetries = n }
+}
+
+// NewClient returns a Client authenticating with the given bearer token.
+func NewClient(token string, opts ...Option) *Client {
+	c := &Client{
+		endpoint:   defaultEndpoint,
+		token:      token,
+		httpClient: http.DefaultClient,
+		maxRetries: 3,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Query executes an arbitrary GraphQL query or mutation with the given
+// variables and returns the raw "data" object, for callers (like `copilot
+// query`) that don't have a typed response to decode into.
+func (c *Client) Query(ctx context.Context, query string, variables map[string]any) (json.RawMessage, error) {
+	var data json.RawMessage
+	if err := c.do(ctx, query, variables, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors Errors          `json:"errors,omitempty"`
+}
+
+// do executes a GraphQL query/mutation, retrying transient failures with
+// exponential backoff, and decodes the "data" field into out.
+func (c *Client) do(ctx context.Context, query string, variables map[string]any, out any) error {
+	body, err := json.Marshal(graphqlRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("api: encode request: %w", err)
+	}
+
+	backoff := 250 * time.Millisecond
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		gqlResp, retryable, err := c.send(ctx, body)
+		if err != nil {
+			lastErr = err
+			if !retryable {
+				return err
+			}
+			continue
+		}
+
+		if len(gqlResp.Errors) > 0 {
+			return gqlResp.Errors
+		}
+		if out == nil {
+			return nil
+		}
+		return json.Unmarshal(gqlResp.Data, out)
+	}
+	return fmt.Errorf("api: request failed after %d attempts: %w", c.maxRetries+1, lastErr)
+}
+
+// send performs a single HTTP round trip. The second return value reports
+// whether a non-nil err is worth retrying (e.g. a transient 5xx).
+func (c *Client) send(ctx context.Context, body []byte) (graphqlResponse, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return graphqlResponse{}, false, fmt.Errorf("api: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return graphqlResponse{}, true, fmt.Errorf("api: send request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusInternalServerError {
+		return graphqlResponse{}, true, fmt.Errorf("api: server returned %s", httpResp.Status)
+	}
+	if httpResp.StatusCode >= http.StatusBadRequest {
+		return graphqlResponse{}, false, fmt.Errorf("api: server returned %s", httpResp.Status)
+	}
+
+	var gqlResp graphqlResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&gqlResp); err != nil {
+		return graphqlResponse{}, false, fmt.Errorf("api: decode response: %w", err)
+	}
+	return gqlResp, false, nil
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientRetriesOnServerError(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(graphqlResponse{Data: json.RawMessage(`{"ok":true}`)})
+	}))
+	defer srv.Close()
+
+	client := NewClient("token", WithEndpoint(srv.URL), WithMaxRetries(2))
+
+	var out struct {
+		OK bool `json:"ok"`
+	}
+	if err := client.do(context.Background(), "query{x}", nil, &out); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	if !out.OK {
+		t.Fatal("expected ok=true")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("expected 2 attempts (1 failure + 1 retry), got %d", got)
+	}
+}
+
+func TestClientDecodesGraphQLErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(graphqlResponse{
+			Errors: Errors{{Message: "not authorized"}},
+		})
+	}))
+	defer srv.Close()
+
+	client := NewClient("token", WithEndpoint(srv.URL))
+
+	err := client.do(context.Background(), "query{x}", nil, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	gqlErr, ok := err.(Errors)
+	if !ok {
+		t.Fatalf("expected Errors, got %T: %v", err, err)
+	}
+	if len(gqlErr) != 1 || gqlErr[0].Message != "not authorized" {
+		t.Fatalf("unexpected errors: %+v", gqlErr)
+	}
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// dateLayout matches Copilot's GraphQL "Date" scalar, which serializes as a
+// bare "YYYY-MM-DD" rather than a full RFC3339 timestamp.
+const dateLayout = "2006-01-02"
+
+// Date is a day-granularity calendar date, as returned by Copilot's GraphQL
+// "Date" scalar. encoding/json's default time.Time unmarshaling requires
+// RFC3339, so Date parses the bare YYYY-MM-DD form instead.
+type Date struct {
+	time.Time
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(dateLayout))
+}
+
+// MarshalYAML keeps --output yaml consistent with --output json: without
+// this, yaml.v3 would fall through to the embedded time.Time's MarshalText
+// and render a full RFC3339 timestamp instead of the bare date.
+func (d Date) MarshalYAML() (any, error) {
+	return d.Format(dateLayout), nil
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("api: unmarshal date: %w", err)
+	}
+
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return fmt.Errorf("api: parse date %q: %w", s, err)
+	}
+	d.Time = t
+	return nil
+}
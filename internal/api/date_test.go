@@ -0,0 +1,23 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDateUnmarshalJSON(t *testing.T) {
+	var d Date
+	if err := json.Unmarshal([]byte(`"2025-01-31"`), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got := d.Format(dateLayout); got != "2025-01-31" {
+		t.Fatalf("got %q, want %q", got, "2025-01-31")
+	}
+}
+
+func TestDateUnmarshalJSONRejectsRFC3339(t *testing.T) {
+	var d Date
+	if err := json.Unmarshal([]byte(`"2025-01-31T00:00:00Z"`), &d); err == nil {
+		t.Fatal("expected an error for a non-bare-date value")
+	}
+}
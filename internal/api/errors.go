@@ -0,0 +1,21 @@
+package api
+
+import "strings"
+
+// Error is a single entry from a GraphQL response's "errors" array.
+type Error struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+// Errors is the "errors" array of a GraphQL response. It implements error so
+// callers can treat a failed request like any other Go error.
+type Errors []Error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Message
+	}
+	return "api: " + strings.Join(msgs, "; ")
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// Transaction is a single posted or pending transaction on an account.
+type Transaction struct {
+	ID        string  `json:"id" yaml:"id"`
+	AccountID string  `json:"accountId" yaml:"accountId"`
+	Date      Date    `json:"date" yaml:"date"`
+	Merchant  string  `json:"merchant" yaml:"merchant"`
+	Category  string  `json:"category" yaml:"category"`
+	Amount    float64 `json:"amount" yaml:"amount"`
+}
+
+// ListTransactionsParams narrows a ListTransactions call. Zero values are
+// omitted from the GraphQL variables, matching the API's optional filters.
+type ListTransactionsParams struct {
+	Since     time.Time
+	Until     time.Time
+	AccountID string
+}
+
+const listTransactionsQuery = `query ListTransactions($since: Date, $until: Date, $accountId: ID) {
+	transactions(since: $since, until: $until, accountId: $accountId) {
+		id
+		accountId
+		date
+		merchant
+		category
+		amount
+	}
+}`
+
+// ListTransactions fetches transactions matching the given filters.
+func (c *Client) ListTransactions(ctx context.Context, params ListTransactionsParams) ([]Transaction, error) {
+	variables := map[string]any{}
+	if !params.Since.IsZero() {
+		variables["since"] = params.Since.Format(dateLayout)
+	}
+	if !params.Until.IsZero() {
+		variables["until"] = params.Until.Format(dateLayout)
+	}
+	if params.AccountID != "" {
+		variables["accountId"] = params.AccountID
+	}
+
+	var out struct {
+		Transactions []Transaction `json:"transactions"`
+	}
+	if err := c.do(ctx, listTransactionsQuery, variables, &out); err != nil {
+		return nil, err
+	}
+	return out.Transactions, nil
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/javisoto/copilot-money-api/internal/api"
+)
+
+func newAccountsCmd(flags *rootFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Work with linked accounts",
+	}
+
+	cmd.AddCommand(newAccountsListCmd(flags))
+
+	return cmd
+}
+
+type accountRow api.Account
+
+func (r accountRow) tableHeader() table.Row {
+	return table.Row{"ID", "Name", "Type", "Balance"}
+}
+
+func (r accountRow) tableRow() table.Row {
+	return table.Row{r.ID, r.Name, r.Type, r.Balance}
+}
+
+func newAccountsListCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List linked accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newAPIClient(flags)
+			if err != nil {
+				return err
+			}
+
+			stop := startSpinner(cmd.Context(), "Fetching accounts...")
+			accounts, err := client.ListAccounts(cmd.Context())
+			stop()
+			if err != nil {
+				return fmt.Errorf("list accounts: %w", err)
+			}
+
+			rows := make([]accountRow, len(accounts))
+			for i, a := range accounts {
+				rows[i] = accountRow(a)
+			}
+			return render(os.Stdout, flags, rows)
+		},
+	}
+}
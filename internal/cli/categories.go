@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/javisoto/copilot-money-api/internal/api"
+)
+
+func newCategoriesCmd(flags *rootFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "categories",
+		Short: "Work with budgeting categories",
+	}
+
+	cmd.AddCommand(newCategoriesListCmd(flags))
+
+	return cmd
+}
+
+type categoryRow api.Category
+
+func (r categoryRow) tableHeader() table.Row {
+	return table.Row{"ID", "Name", "Group", "Hidden"}
+}
+
+func (r categoryRow) tableRow() table.Row {
+	return table.Row{r.ID, r.Name, r.Group, r.Hidden}
+}
+
+func newCategoriesListCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List budgeting categories",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newAPIClient(flags)
+			if err != nil {
+				return err
+			}
+
+			stop := startSpinner(cmd.Context(), "Fetching categories...")
+			categories, err := client.ListCategories(cmd.Context())
+			stop()
+			if err != nil {
+				return fmt.Errorf("list categories: %w", err)
+			}
+
+			rows := make([]categoryRow, len(categories))
+			for i, c := range categories {
+				rows[i] = categoryRow(c)
+			}
+			return render(os.Stdout, flags, rows)
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/javisoto/copilot-money-api/internal/api"
+	"github.com/javisoto/copilot-money-api/internal/config"
+)
+
+// newAPIClient builds a GraphQL client for the context selected by
+// flags.contextName (or the config's current context if unset), falling
+// back to COPILOT_TOKEN when no context has been configured yet.
+func newAPIClient(flags *rootFlags) (*api.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := cfg.Current(flags.contextName)
+	if err != nil {
+		if token := os.Getenv("COPILOT_TOKEN"); token != "" && len(cfg.Contexts) == 0 {
+			return api.NewClient(token), nil
+		}
+		return nil, err
+	}
+
+	token, err := config.Token(ctx.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []api.Option
+	if ctx.Endpoint != "" {
+		opts = append(opts, api.WithEndpoint(ctx.Endpoint))
+	}
+	return api.NewClient(token, opts...), nil
+}
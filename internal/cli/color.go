@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+type colorMode string
+
+const (
+	colorAuto colorMode = "auto"
+	colorYes  colorMode = "yes"
+	colorNo   colorMode = "no"
+)
+
+// colorModeFlag parses --color.
+type colorModeFlag struct {
+	value *colorMode
+}
+
+func (f *colorModeFlag) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return string(*f.value)
+}
+
+func (f *colorModeFlag) Set(s string) error {
+	*f.value = colorMode(s)
+	return nil
+}
+
+func (f *colorModeFlag) Type() string {
+	return "color"
+}
+
+// effectiveColor resolves --color against NO_COLOR/FORCE_COLOR and whether
+// stdout is a terminal.
+func effectiveColor(mode colorMode) bool {
+	switch mode {
+	case colorYes:
+		return true
+	case colorNo:
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if os.Getenv("FORCE_COLOR") != "" {
+		return true
+	}
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/javisoto/copilot-money-api/internal/config"
+)
+
+func newContextCmd(flags *rootFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Manage saved Copilot profiles",
+	}
+
+	cmd.AddCommand(newContextListCmd(flags))
+	cmd.AddCommand(newContextUseCmd())
+	cmd.AddCommand(newContextCreateCmd())
+	cmd.AddCommand(newContextDeleteCmd())
+
+	return cmd
+}
+
+type contextRow struct {
+	Name     string `json:"name" yaml:"name"`
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	Current  bool   `json:"current" yaml:"current"`
+}
+
+func (r contextRow) tableHeader() table.Row {
+	return table.Row{"Name", "Endpoint", "Current"}
+}
+
+func (r contextRow) tableRow() table.Row {
+	return table.Row{r.Name, r.Endpoint, r.Current}
+}
+
+func newContextListCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List saved contexts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			rows := make([]contextRow, len(cfg.Contexts))
+			for i, ctx := range cfg.Contexts {
+				rows[i] = contextRow{
+					Name:     ctx.Name,
+					Endpoint: ctx.Endpoint,
+					Current:  ctx.Name == cfg.CurrentContext,
+				}
+			}
+			return render(os.Stdout, flags, rows)
+		},
+	}
+}
+
+func newContextUseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Switch the current context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if err := config.ValidateName(name); err != nil {
+				return err
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Context(name); !ok {
+				return fmt.Errorf("unknown context %q", name)
+			}
+
+			cfg.CurrentContext = name
+			return cfg.Save()
+		},
+	}
+}
+
+func newContextCreateCmd() *cobra.Command {
+	var endpoint, token string
+	var use bool
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if err := config.ValidateName(name); err != nil {
+				return err
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Context(name); ok {
+				return fmt.Errorf("context %q already exists", name)
+			}
+
+			if token == "" {
+				token, err = promptForToken(cmd)
+				if err != nil {
+					return err
+				}
+			}
+			if err := config.SetToken(name, token); err != nil {
+				return err
+			}
+
+			cfg.Contexts = append(cfg.Contexts, config.Context{Name: name, Endpoint: endpoint})
+			if use || cfg.CurrentContext == "" {
+				cfg.CurrentContext = name
+			}
+			return cfg.Save()
+		},
+	}
+
+	cmd.Flags().StringVar(&endpoint, "endpoint", "", "GraphQL endpoint for this context (defaults to Copilot's production API)")
+	cmd.Flags().StringVar(&token, "token", "", "Auth token (prompted for if omitted)")
+	cmd.Flags().BoolVar(&use, "use", false, "Make this the current context")
+
+	return cmd
+}
+
+func newContextDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if err := config.ValidateName(name); err != nil {
+				return err
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			idx := -1
+			for i, ctx := range cfg.Contexts {
+				if ctx.Name == name {
+					idx = i
+					break
+				}
+			}
+			if idx == -1 {
+				return fmt.Errorf("unknown context %q", name)
+			}
+
+			cfg.Contexts = append(cfg.Contexts[:idx], cfg.Contexts[idx+1:]...)
+			if cfg.CurrentContext == name {
+				cfg.CurrentContext = ""
+			}
+			if err := config.DeleteToken(name); err != nil {
+				return err
+			}
+			return cfg.Save()
+		},
+	}
+}
+
+func promptForToken(cmd *cobra.Command) (string, error) {
+	fmt.Fprint(cmd.OutOrStdout(), "Token: ")
+	scanner := bufio.NewScanner(cmd.InOrStdin())
+	if !scanner.Scan() {
+		return "", fmt.Errorf("no token provided")
+	}
+	return strings.TrimSpace(scanner.Text()), nil
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/javisoto/copilot-money-api/internal/cli/values"
+)
+
+func newQueryCmd(flags *rootFlags) *cobra.Command {
+	var queryArg string
+	valueOpts := &values.Options{}
+
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Run a raw GraphQL query or mutation",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query, err := resolveQuery(queryArg)
+			if err != nil {
+				return err
+			}
+
+			variables, err := valueOpts.MergeValues()
+			if err != nil {
+				return err
+			}
+
+			client, err := newAPIClient(flags)
+			if err != nil {
+				return err
+			}
+
+			data, err := client.Query(cmd.Context(), query, variables)
+			if err != nil {
+				return fmt.Errorf("query: %w", err)
+			}
+
+			_, err = os.Stdout.Write(data)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&queryArg, "query", "q", "", "GraphQL query/mutation, or @file.graphql to read it from a file")
+	_ = cmd.MarkFlagRequired("query")
+	valueOpts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// resolveQuery reads the query from a file when arg is "@path/to/file".
+func resolveQuery(arg string) (string, error) {
+	path, ok := strings.CutPrefix(arg, "@")
+	if !ok {
+		return arg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read query file %s: %w", path, err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"gopkg.in/yaml.v3"
+)
+
+// tableRow lets a resource row render itself as a table.Row without the
+// command that fetched it knowing about go-pretty/table directly.
+type tableRow interface {
+	tableHeader() table.Row
+	tableRow() table.Row
+}
+
+// render writes rows in the flags' effective output format, sharing one code
+// path across every resource command so new resources only need to implement
+// tableRow to pick up json/table/yaml/template for free.
+func render[T tableRow](w io.Writer, flags *rootFlags, rows []T) error {
+	switch flags.output {
+	case OutputFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	case OutputFormatYAML:
+		return renderYAML(w, rows)
+	case OutputFormatTemplate:
+		return renderTemplate(w, flags.template, rows)
+	case OutputFormatTable:
+		tw := table.NewWriter()
+		tw.SetOutputMirror(w)
+		if len(rows) > 0 {
+			tw.AppendHeader(rows[0].tableHeader())
+		}
+		for _, r := range rows {
+			tw.AppendRow(r.tableRow())
+		}
+		tw.Render()
+		return nil
+	default:
+		return errors.New("unreachable output format")
+	}
+}
+
+// renderYAML marshals rows directly (row structs carry `yaml` tags mirroring
+// their `json` ones) so field names and order match --output json exactly,
+// rather than funneling through an unordered map[string]any.
+func renderYAML(w io.Writer, v any) error {
+	enc := yaml.NewEncoder(w)
+	defer enc.Close()
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("marshal yaml: %w", err)
+	}
+	return nil
+}
+
+func renderTemplate(w io.Writer, text string, v any) error {
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return fmt.Errorf("parse --output template: %w", err)
+	}
+	return tmpl.Execute(w, v)
+}
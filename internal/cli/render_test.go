@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRenderYAMLFieldOrderMatchesJSON(t *testing.T) {
+	rows := []helloRow{{Key: "status", Value: "ok"}}
+
+	var jsonBuf, yamlBuf bytes.Buffer
+	if err := render(&jsonBuf, &rootFlags{output: OutputFormatJSON}, rows); err != nil {
+		t.Fatalf("render json: %v", err)
+	}
+	if err := render(&yamlBuf, &rootFlags{output: OutputFormatYAML}, rows); err != nil {
+		t.Fatalf("render yaml: %v", err)
+	}
+
+	yamlOut := yamlBuf.String()
+	keyIdx := strings.Index(yamlOut, "key:")
+	valueIdx := strings.Index(yamlOut, "value:")
+	if keyIdx < 0 || valueIdx < 0 || keyIdx > valueIdx {
+		t.Fatalf("expected yaml fields in declared order (key before value), got:\n%s", yamlOut)
+	}
+}
@@ -1,10 +1,9 @@
 package cli
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
@@ -13,17 +12,23 @@ import (
 type OutputFormat string
 
 const (
-	OutputFormatJSON  OutputFormat = "json"
-	OutputFormatTable OutputFormat = "table"
+	OutputFormatJSON     OutputFormat = "json"
+	OutputFormatTable    OutputFormat = "table"
+	OutputFormatYAML     OutputFormat = "yaml"
+	OutputFormatTemplate OutputFormat = "template"
 )
 
 type rootFlags struct {
-	output OutputFormat
+	output      OutputFormat
+	template    string
+	contextName string
+	color       colorMode
 }
 
 func NewRootCmd() *cobra.Command {
 	flags := &rootFlags{
 		output: OutputFormatTable,
+		color:  colorAuto,
 	}
 
 	cmd := &cobra.Command{
@@ -32,36 +37,73 @@ func NewRootCmd() *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			if flags.output != OutputFormatJSON && flags.output != OutputFormatTable {
-				return fmt.Errorf("invalid --output %q (expected: json|table)", flags.output)
+			switch flags.output {
+			case OutputFormatJSON, OutputFormatTable, OutputFormatYAML:
+			case OutputFormatTemplate:
+				if flags.template == "" {
+					return fmt.Errorf("--output template requires a template, e.g. --output go-template='{{.}}'")
+				}
+			default:
+				return fmt.Errorf("invalid --output %q (expected: json|table|yaml|go-template=...)", flags.output)
+			}
+
+			switch flags.color {
+			case colorAuto, colorYes, colorNo:
+			default:
+				return fmt.Errorf("invalid --color %q (expected: yes|no|auto)", flags.color)
 			}
+
+			quiet := flags.output == OutputFormatJSON || flags.output == OutputFormatYAML
+			cmd.SetContext(withUIState(cmd.Context(), uiState{
+				color: !quiet && effectiveColor(flags.color),
+				quiet: quiet,
+			}))
 			return nil
 		},
 	}
 
 	cmd.PersistentFlags().StringVar(&cmd.Version, "version", "", "Print version (alias: `version` command)")
 	_ = cmd.PersistentFlags().MarkHidden("version")
-	cmd.PersistentFlags().Var(&outputFormatFlag{value: &flags.output}, "output", "Output format: json|table")
+	cmd.PersistentFlags().Var(&outputFormatFlag{flags: flags}, "output", "Output format: json|table|yaml|go-template=...")
+	cmd.PersistentFlags().StringVar(&flags.contextName, "context", "", "Name of the context (profile) to use (defaults to the current context)")
+	cmd.PersistentFlags().Var(&colorModeFlag{value: &flags.color}, "color", "Color output: yes|no|auto")
 
 	cmd.AddCommand(newVersionCmd())
 	cmd.AddCommand(newHelloCmd(flags))
+	cmd.AddCommand(newAccountsCmd(flags))
+	cmd.AddCommand(newTransactionsCmd(flags))
+	cmd.AddCommand(newCategoriesCmd(flags))
+	cmd.AddCommand(newContextCmd(flags))
+	cmd.AddCommand(newQueryCmd(flags))
 
 	return cmd
 }
 
+// outputFormatFlag parses --output, recognizing the bare "template" value's
+// "go-template=..." (or "template=...") form and splitting off the template
+// text into flags.template.
 type outputFormatFlag struct {
-	value *OutputFormat
+	flags *rootFlags
 }
 
 func (f *outputFormatFlag) String() string {
-	if f.value == nil {
+	if f.flags == nil {
 		return ""
 	}
-	return string(*f.value)
+	return string(f.flags.output)
 }
 
 func (f *outputFormatFlag) Set(s string) error {
-	*f.value = OutputFormat(s)
+	switch {
+	case strings.HasPrefix(s, "go-template="):
+		f.flags.output = OutputFormatTemplate
+		f.flags.template = strings.TrimPrefix(s, "go-template=")
+	case strings.HasPrefix(s, "template="):
+		f.flags.output = OutputFormatTemplate
+		f.flags.template = strings.TrimPrefix(s, "template=")
+	default:
+		f.flags.output = OutputFormat(s)
+	}
 	return nil
 }
 
@@ -80,39 +122,31 @@ func newVersionCmd() *cobra.Command {
 	}
 }
 
-func newHelloCmd(flags *rootFlags) *cobra.Command {
-	type row struct {
-		Key   string `json:"key"`
-		Value string `json:"value"`
-	}
+type helloRow struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+func (r helloRow) tableHeader() table.Row {
+	return table.Row{"Key", "Value"}
+}
+
+func (r helloRow) tableRow() table.Row {
+	return table.Row{r.Key, r.Value}
+}
 
+func newHelloCmd(flags *rootFlags) *cobra.Command {
 	return &cobra.Command{
 		Use:    "hello",
 		Short:  "Sanity check output modes",
 		Hidden: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			rows := []row{
+			rows := []helloRow{
 				{Key: "status", Value: "ok"},
 				{Key: "next", Value: "capture GraphQL endpoints"},
 			}
 
-			switch flags.output {
-			case OutputFormatJSON:
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(rows)
-			case OutputFormatTable:
-				tw := table.NewWriter()
-				tw.SetOutputMirror(os.Stdout)
-				tw.AppendHeader(table.Row{"Key", "Value"})
-				for _, r := range rows {
-					tw.AppendRow(table.Row{r.Key, r.Value})
-				}
-				tw.Render()
-				return nil
-			default:
-				return errors.New("unreachable output format")
-			}
+			return render(os.Stdout, flags, rows)
 		},
 	}
 }
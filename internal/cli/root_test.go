@@ -3,12 +3,26 @@ package cli
 import "testing"
 
 func TestOutputFormatFlag(t *testing.T) {
-	var f OutputFormat = OutputFormatTable
-	flag := &outputFormatFlag{value: &f}
+	flags := &rootFlags{output: OutputFormatTable}
+	flag := &outputFormatFlag{flags: flags}
 	if err := flag.Set("json"); err != nil {
 		t.Fatalf("Set: %v", err)
 	}
-	if f != OutputFormatJSON {
-		t.Fatalf("expected %q, got %q", OutputFormatJSON, f)
+	if flags.output != OutputFormatJSON {
+		t.Fatalf("expected %q, got %q", OutputFormatJSON, flags.output)
+	}
+}
+
+func TestOutputFormatFlagGoTemplate(t *testing.T) {
+	flags := &rootFlags{output: OutputFormatTable}
+	flag := &outputFormatFlag{flags: flags}
+	if err := flag.Set("go-template={{.Key}}"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if flags.output != OutputFormatTemplate {
+		t.Fatalf("expected %q, got %q", OutputFormatTemplate, flags.output)
+	}
+	if flags.template != "{{.Key}}" {
+		t.Fatalf("expected template %q, got %q", "{{.Key}}", flags.template)
 	}
 }
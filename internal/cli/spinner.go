@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/briandowns/spinner"
+)
+
+// startSpinner starts a spinner bound to msg on stderr and returns a func
+// that stops it. Under --output json/yaml the spinner is fully suppressed
+// (it returns a no-op stopper) so stdout stays the only thing written and
+// nothing races with a script parsing it.
+func startSpinner(ctx context.Context, msg string) func() {
+	state := uiStateFromContext(ctx)
+	if state.quiet {
+		return func() {}
+	}
+
+	s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = " " + msg
+	if state.color {
+		_ = s.Color("cyan")
+	}
+	s.Start()
+	return s.Stop
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/javisoto/copilot-money-api/internal/api"
+)
+
+const dateFlagLayout = "2006-01-02"
+
+func newTransactionsCmd(flags *rootFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transactions",
+		Short: "Work with transactions",
+	}
+
+	cmd.AddCommand(newTransactionsListCmd(flags))
+
+	return cmd
+}
+
+type transactionRow api.Transaction
+
+func (r transactionRow) tableHeader() table.Row {
+	return table.Row{"ID", "Date", "Account", "Merchant", "Category", "Amount"}
+}
+
+func (r transactionRow) tableRow() table.Row {
+	return table.Row{r.ID, r.Date.Format(dateFlagLayout), r.AccountID, r.Merchant, r.Category, r.Amount}
+}
+
+func newTransactionsListCmd(flags *rootFlags) *cobra.Command {
+	var since, until, account string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List transactions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := parseTransactionsListFlags(since, until, account)
+			if err != nil {
+				return err
+			}
+
+			client, err := newAPIClient(flags)
+			if err != nil {
+				return err
+			}
+
+			stop := startSpinner(cmd.Context(), "Fetching transactions...")
+			transactions, err := client.ListTransactions(cmd.Context(), params)
+			stop()
+			if err != nil {
+				return fmt.Errorf("list transactions: %w", err)
+			}
+
+			rows := make([]transactionRow, len(transactions))
+			for i, t := range transactions {
+				rows[i] = transactionRow(t)
+			}
+			return render(os.Stdout, flags, rows)
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "", "Only include transactions on or after this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&until, "until", "", "Only include transactions on or before this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&account, "account", "", "Only include transactions for this account ID")
+
+	return cmd
+}
+
+func parseTransactionsListFlags(since, until, account string) (api.ListTransactionsParams, error) {
+	params := api.ListTransactionsParams{AccountID: account}
+
+	if since != "" {
+		t, err := time.Parse(dateFlagLayout, since)
+		if err != nil {
+			return params, fmt.Errorf("invalid --since %q (expected YYYY-MM-DD): %w", since, err)
+		}
+		params.Since = t
+	}
+	if until != "" {
+		t, err := time.Parse(dateFlagLayout, until)
+		if err != nil {
+			return params, fmt.Errorf("invalid --until %q (expected YYYY-MM-DD): %w", until, err)
+		}
+		params.Until = t
+	}
+
+	return params, nil
+}
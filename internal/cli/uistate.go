@@ -0,0 +1,28 @@
+package cli
+
+import "context"
+
+type uiStateKey struct{}
+
+// uiState is the effective color/tty state computed once in
+// PersistentPreRunE and threaded through subcommands via the command's
+// context, so they don't each re-detect it.
+type uiState struct {
+	// color reports whether colored decoration (colored text, a tinted
+	// spinner) should be emitted. Whether a spinner is shown at all is
+	// decided by quiet alone.
+	color bool
+	// quiet reports whether the output format requires stdout to stay
+	// machine-parseable, silencing spinners and colored decoration
+	// regardless of the color setting.
+	quiet bool
+}
+
+func withUIState(ctx context.Context, state uiState) context.Context {
+	return context.WithValue(ctx, uiStateKey{}, state)
+}
+
+func uiStateFromContext(ctx context.Context) uiState {
+	state, _ := ctx.Value(uiStateKey{}).(uiState)
+	return state
+}
@@ -0,0 +1,107 @@
+package values
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var segmentRE = regexp.MustCompile(`^([^\[\]]*)((?:\[\d+\])*)$`)
+var indexRE = regexp.MustCompile(`\[(\d+)\]`)
+
+// setPath sets value at the dotted path (with optional array indices, e.g.
+// "a.b[0].c") inside base, creating intermediate maps/slices as needed.
+func setPath(base map[string]any, path string, value any) error {
+	segments := splitUnescaped(path, '.')
+	if len(segments) == 0 || segments[0] == "" {
+		return fmt.Errorf("values: %q is not a valid key", path)
+	}
+
+	cur := base
+	for i, seg := range segments {
+		key, indices, err := parseSegment(seg)
+		if err != nil {
+			return fmt.Errorf("values: %q: %w", path, err)
+		}
+		last := i == len(segments)-1
+
+		if len(indices) == 0 {
+			if last {
+				cur[key] = value
+				return nil
+			}
+
+			next, ok := cur[key].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				cur[key] = next
+			}
+			cur = next
+			continue
+		}
+
+		existing, _ := cur[key].([]any)
+
+		if last {
+			cur[key] = setIndices(existing, indices, func(leaf []any, idx int) {
+				leaf[idx] = value
+			})
+			return nil
+		}
+
+		var next map[string]any
+		cur[key] = setIndices(existing, indices, func(leaf []any, idx int) {
+			m, ok := leaf[idx].(map[string]any)
+			if !ok {
+				m = map[string]any{}
+				leaf[idx] = m
+			}
+			next = m
+		})
+		cur = next
+	}
+	return nil
+}
+
+// setIndices walks indices into (possibly nil) slice, growing and nesting
+// slices as needed, and calls set on the innermost slice once fully
+// resolved. It returns the (possibly new) outer slice so the caller can
+// write it back into its parent, since appending to grow a slice can
+// reallocate it.
+func setIndices(slice []any, indices []int, set func(leaf []any, idx int)) []any {
+	idx := indices[0]
+	slice = growSlice(slice, idx)
+
+	if len(indices) == 1 {
+		set(slice, idx)
+		return slice
+	}
+
+	nested, _ := slice[idx].([]any)
+	slice[idx] = setIndices(nested, indices[1:], set)
+	return slice
+}
+
+func growSlice(s []any, idx int) []any {
+	for len(s) <= idx {
+		s = append(s, nil)
+	}
+	return s
+}
+
+func parseSegment(seg string) (string, []int, error) {
+	m := segmentRE.FindStringSubmatch(seg)
+	if m == nil || m[1] == "" {
+		return "", nil, fmt.Errorf("invalid key %q", seg)
+	}
+
+	var indices []int
+	for _, idxMatch := range indexRE.FindAllStringSubmatch(m[2], -1) {
+		n, err := strconv.Atoi(idxMatch[1])
+		if err != nil {
+			return "", nil, err
+		}
+		indices = append(indices, n)
+	}
+	return m[1], indices, nil
+}
@@ -0,0 +1,163 @@
+// Package values implements Helm-style --set/--set-string/--set-file/-f
+// flags that merge into a single map[string]any of GraphQL variables.
+package values
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// Options captures the raw --set/--set-string/--set-file/-f flag values.
+// Call AddFlags to register them on a command and MergeValues to resolve
+// them into variables.
+type Options struct {
+	ValueFiles   []string
+	Values       []string
+	StringValues []string
+	FileValues   []string
+}
+
+// AddFlags registers --set, --set-string, --set-file, and -f/--values on f.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringArrayVar(&o.Values, "set", nil, "Set a variable (key=val, can be repeated or comma-separated: key1=val1,key2=val2)")
+	f.StringArrayVar(&o.StringValues, "set-string", nil, "Set a variable as a string, skipping type inference (key=val)")
+	f.StringArrayVar(&o.FileValues, "set-file", nil, "Set a variable from the contents of a file (key=path)")
+	f.StringSliceVarP(&o.ValueFiles, "values", "f", nil, "Read variables from a YAML file (can be repeated)")
+}
+
+// MergeValues resolves every source into one map, applied in the same
+// precedence order Helm uses: -f/--values files first (so later files
+// override earlier ones), then --set, --set-string, and --set-file, so a
+// --set can override one field of an otherwise file-sourced value.
+func (o *Options) MergeValues() (map[string]any, error) {
+	base := map[string]any{}
+
+	for _, path := range o.ValueFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("values: read %s: %w", path, err)
+		}
+
+		var m map[string]any
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("values: parse %s: %w", path, err)
+		}
+		base = mergeMaps(base, m)
+	}
+
+	if err := applyAll(base, o.Values, parseTypedValue); err != nil {
+		return nil, err
+	}
+	if err := applyAll(base, o.StringValues, func(s string) (any, error) { return s, nil }); err != nil {
+		return nil, err
+	}
+	if err := applyAll(base, o.FileValues, readFileValue); err != nil {
+		return nil, err
+	}
+
+	return base, nil
+}
+
+func applyAll(base map[string]any, sets []string, toValue func(string) (any, error)) error {
+	for _, set := range sets {
+		if err := applySet(base, set, toValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applySet parses a comma-separated "key=val,key2=val2" string, converting
+// each val with toValue, and sets each into base at its dotted path.
+func applySet(base map[string]any, raw string, toValue func(string) (any, error)) error {
+	for _, pair := range splitUnescaped(raw, ',') {
+		if pair == "" {
+			continue
+		}
+
+		key, val, ok := strings.Cut(pair, "=")
+		if !ok {
+			return fmt.Errorf("values: %q is missing \"=\"", pair)
+		}
+
+		v, err := toValue(val)
+		if err != nil {
+			return fmt.Errorf("values: %s: %w", key, err)
+		}
+		if err := setPath(base, key, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readFileValue(path string) (any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return string(data), nil
+}
+
+// parseTypedValue infers bool/null/int/float/string from an unquoted --set
+// value, matching Helm's --set semantics.
+func parseTypedValue(s string) (any, error) {
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, nil
+	}
+	return s, nil
+}
+
+func mergeMaps(dst, src map[string]any) map[string]any {
+	for k, v := range src {
+		if srcMap, ok := v.(map[string]any); ok {
+			if dstMap, ok := dst[k].(map[string]any); ok {
+				dst[k] = mergeMaps(dstMap, srcMap)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+	return dst
+}
+
+// splitUnescaped splits s on sep, treating a backslash-escaped sep as a
+// literal character rather than a delimiter.
+func splitUnescaped(s string, sep rune) []string {
+	var parts []string
+	var cur strings.Builder
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == sep:
+			parts = append(parts, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	parts = append(parts, cur.String())
+	return parts
+}
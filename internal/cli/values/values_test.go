@@ -0,0 +1,65 @@
+package values
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMergeValuesSetDottedAndIndexed(t *testing.T) {
+	opts := &Options{
+		Values:       []string{"a.b.c=1,a.b.d=true"},
+		StringValues: []string{"a.e[0]=x,a.e[1]=y"},
+	}
+
+	got, err := opts.MergeValues()
+	if err != nil {
+		t.Fatalf("MergeValues: %v", err)
+	}
+
+	want := map[string]any{
+		"a": map[string]any{
+			"b": map[string]any{
+				"c": 1,
+				"d": true,
+			},
+			"e": []any{"x", "y"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestMergeValuesSetNestedIndices(t *testing.T) {
+	opts := &Options{Values: []string{"a[0][1]=5"}}
+
+	got, err := opts.MergeValues()
+	if err != nil {
+		t.Fatalf("MergeValues: %v", err)
+	}
+
+	want := map[string]any{
+		"a": []any{[]any{nil, 5}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestMergeValuesSetOverridesValuesFile(t *testing.T) {
+	valuesFile := filepath.Join(t.TempDir(), "values.yaml")
+	if err := os.WriteFile(valuesFile, []byte("month: \"2024-12\"\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	opts := &Options{ValueFiles: []string{valuesFile}, Values: []string{"month=2025-01"}}
+
+	got, err := opts.MergeValues()
+	if err != nil {
+		t.Fatalf("MergeValues: %v", err)
+	}
+	if got["month"] != "2025-01" {
+		t.Fatalf("got %#v", got)
+	}
+}
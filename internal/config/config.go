@@ -0,0 +1,132 @@
+// Package config manages the CLI's persisted profiles ("contexts"), each
+// pairing a GraphQL endpoint with a reference to a stored auth token, so
+// users can switch between e.g. personal and family Copilot accounts
+// without re-authenticating.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+var contextNameRE = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ValidateName rejects context names that aren't safe to use as a token
+// filename and keychain account, e.g. "../../etc/passwd".
+func ValidateName(name string) error {
+	if !contextNameRE.MatchString(name) {
+		return fmt.Errorf("config: invalid context name %q (expected letters, digits, '-', or '_')", name)
+	}
+	return nil
+}
+
+// Context is one named profile: which endpoint to talk to. Its auth token is
+// stored separately, see SetToken/Token.
+type Context struct {
+	Name     string `yaml:"name"`
+	Endpoint string `yaml:"endpoint"`
+}
+
+// Config is the on-disk shape of $XDG_CONFIG_HOME/copilot/config.yaml.
+type Config struct {
+	CurrentContext string    `yaml:"currentContext"`
+	Contexts       []Context `yaml:"contexts"`
+}
+
+// Dir returns the directory config.yaml and token files live in, creating it
+// if necessary.
+func Dir() (string, error) {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("config: resolve home directory: %w", err)
+		}
+		base = filepath.Join(home, ".config")
+	}
+
+	dir := filepath.Join(base, "copilot")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("config: create config dir: %w", err)
+	}
+	return dir, nil
+}
+
+func path() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.yaml"), nil
+}
+
+// Load reads the config file, returning an empty Config if one doesn't exist
+// yet (e.g. before the user has run `copilot context create`).
+func Load() (*Config, error) {
+	p, err := path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config: read %s: %w", p, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("config: parse %s: %w", p, err)
+	}
+	return &cfg, nil
+}
+
+// Save writes cfg to the config file.
+func (c *Config) Save() error {
+	p, err := path()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("config: marshal: %w", err)
+	}
+	if err := os.WriteFile(p, data, 0o600); err != nil {
+		return fmt.Errorf("config: write %s: %w", p, err)
+	}
+	return nil
+}
+
+// Context looks up a context by name.
+func (c *Config) Context(name string) (Context, bool) {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == name {
+			return ctx, true
+		}
+	}
+	return Context{}, false
+}
+
+// Current resolves the context to use: name if non-empty, otherwise the
+// config's CurrentContext.
+func (c *Config) Current(name string) (Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return Context{}, fmt.Errorf("config: no context selected; run `copilot context create`")
+	}
+
+	ctx, ok := c.Context(name)
+	if !ok {
+		return Context{}, fmt.Errorf("config: unknown context %q", name)
+	}
+	return ctx, nil
+}
@@ -0,0 +1,17 @@
+package config
+
+import "testing"
+
+func TestValidateName(t *testing.T) {
+	for _, name := range []string{"personal", "family-2", "work_acct"} {
+		if err := ValidateName(name); err != nil {
+			t.Errorf("ValidateName(%q): %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"../../etc/passwd", "a/b", "", "has space"} {
+		if err := ValidateName(name); err == nil {
+			t.Errorf("ValidateName(%q): expected error, got nil", name)
+		}
+	}
+}
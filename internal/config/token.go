@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/zalando/go-keyring"
+)
+
+const keyringService = "copilot-money-cli"
+
+// SetToken stores the auth token for the named context, preferring the OS
+// keychain and falling back to a 0600 file under the config directory when
+// no keychain is available (e.g. headless Linux without a secret service).
+func SetToken(contextName, token string) error {
+	if err := keyring.Set(keyringService, contextName, token); err == nil {
+		return nil
+	}
+
+	p, err := tokenFilePath(contextName)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(p, []byte(token), 0o600); err != nil {
+		return fmt.Errorf("config: write token file: %w", err)
+	}
+	return nil
+}
+
+// Token retrieves the auth token for the named context, trying the keychain
+// before the file fallback written by SetToken.
+func Token(contextName string) (string, error) {
+	if token, err := keyring.Get(keyringService, contextName); err == nil {
+		return token, nil
+	}
+
+	p, err := tokenFilePath(contextName)
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return "", fmt.Errorf("config: no token stored for context %q: %w", contextName, err)
+	}
+	return string(data), nil
+}
+
+// DeleteToken removes the named context's token from both the keychain and
+// the file fallback.
+func DeleteToken(contextName string) error {
+	_ = keyring.Delete(keyringService, contextName)
+
+	p, err := tokenFilePath(contextName)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("config: remove token file: %w", err)
+	}
+	return nil
+}
+
+func tokenFilePath(contextName string) (string, error) {
+	if err := ValidateName(contextName); err != nil {
+		return "", err
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	tokensDir := filepath.Join(dir, "tokens")
+	if err := os.MkdirAll(tokensDir, 0o700); err != nil {
+		return "", fmt.Errorf("config: create tokens dir: %w", err)
+	}
+	return filepath.Join(tokensDir, contextName), nil
+}